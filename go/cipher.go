@@ -0,0 +1,27 @@
+package hpkvclient
+
+import "errors"
+
+// Cipher transparently encrypts values before they are sent to the server
+// and decrypts them on the way back. Keys are never passed through Cipher,
+// so range queries keep working against plaintext keys.
+type Cipher interface {
+	// Encrypt returns the ciphertext for plaintext.
+	Encrypt(plaintext []byte) (ciphertext []byte, err error)
+	// Decrypt reverses Encrypt.
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// ErrCipherPartialUpdateUnsupported is returned by Set when a Cipher is
+// configured and partialUpdate is requested: the server performs a JSON
+// merge on the stored value, which isn't meaningful against ciphertext.
+var ErrCipherPartialUpdateUnsupported = errors.New("hpkvclient: partial update is not supported when a Cipher is configured")
+
+// WithCipher enables transparent client-side encryption of values. Set
+// encrypts the serialized value before sending it; Get and Query decrypt
+// values on the way back. Keys are left untouched.
+func WithCipher(c Cipher) Option {
+	return func(client *HPKVClient) {
+		client.cipher = c
+	}
+}
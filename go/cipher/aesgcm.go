@@ -0,0 +1,66 @@
+// Package cipher provides the default client-side encryption implementation
+// for HPKVClient's Cipher option.
+package cipher
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// KeySize is the required length, in bytes, of the key passed to NewAESGCM.
+const KeySize = 32
+
+// AESGCM encrypts values with AES-256-GCM, prepending a random nonce to the
+// ciphertext so Decrypt is self-contained.
+type AESGCM struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCM builds an AESGCM cipher from a 32-byte key. Use a key derived
+// from a secure source (e.g. crypto/rand or a KMS-backed secret) — do not
+// pass user-typed passphrases directly.
+func NewAESGCM(key []byte) (*AESGCM, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("cipher: key must be %d bytes, got %d", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("cipher: %w", err)
+	}
+
+	return &AESGCM{aead: aead}, nil
+}
+
+// Encrypt returns nonce || ciphertext, where the nonce is generated randomly
+// for every call.
+func (c *AESGCM) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("cipher: generate nonce: %w", err)
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt reverses Encrypt, reading the nonce off the front of ciphertext.
+func (c *AESGCM) Decrypt(ciphertext []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("cipher: ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	plaintext, err := c.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cipher: decrypt: %w", err)
+	}
+	return plaintext, nil
+}
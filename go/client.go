@@ -1,11 +1,14 @@
 package hpkvclient
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -15,6 +18,15 @@ type HPKVClient struct {
 	client  *resty.Client
 	apiKey  string
 	baseURL string
+
+	// defaultTimeout is the client-wide deadline applied to calls whose
+	// context does not already carry a sooner deadline. See WithDefaultTimeout.
+	defaultTimeout time.Duration
+	// retryPolicy configures automatic retries for transient failures. See WithRetryPolicy.
+	retryPolicy *RetryPolicy
+	// cipher, when set, transparently encrypts values on Set and decrypts
+	// them on Get/Query. See WithCipher.
+	cipher Cipher
 }
 
 // HPKVError represents an error returned by the HPKV API
@@ -32,7 +44,7 @@ func (e *HPKVError) Error() string {
 // NewClient creates a new HPKV client
 // baseURL: The base URL for the HPKV API
 // apiKey: Your HPKV API key
-func NewClient(baseURL, apiKey string) (*HPKVClient, error) {
+func NewClient(baseURL, apiKey string, opts ...Option) (*HPKVClient, error) {
 	if baseURL == "" {
 		return nil, errors.New("baseURL is required")
 	}
@@ -40,34 +52,92 @@ func NewClient(baseURL, apiKey string) (*HPKVClient, error) {
 		return nil, errors.New("apiKey is required")
 	}
 
-	client := resty.New().
-		SetBaseURL(baseURL).
-		SetHeader("x-api-key", apiKey)
-
-	return &HPKVClient{
-		client:  client,
+	c := &HPKVClient{
+		client:  resty.New(),
 		apiKey:  apiKey,
 		baseURL: baseURL,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.client.SetBaseURL(baseURL).SetHeader("x-api-key", apiKey)
+
+	if c.retryPolicy != nil {
+		c.client.SetRetryCount(c.retryPolicy.MaxRetries)
+		if c.retryPolicy.WaitTime > 0 {
+			c.client.SetRetryWaitTime(c.retryPolicy.WaitTime)
+		}
+		if c.retryPolicy.MaxWaitTime > 0 {
+			c.client.SetRetryMaxWaitTime(c.retryPolicy.MaxWaitTime)
+		}
+	}
+
+	return c, nil
+}
+
+// effectiveContext derives the context a call should run under: the earlier
+// of the caller's ctx deadline and the client's default timeout, whichever
+// fires first. The returned cancel func must always be called to release
+// resources.
+func (c *HPKVClient) effectiveContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if c.defaultTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.defaultTimeout)
+}
+
+// translateCtxErr maps context cancellation/deadline errors surfaced by resty
+// onto the package's sentinel errors so callers can errors.Is against them.
+func translateCtxErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) {
+		return ErrCanceled
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrDeadlineExceeded
+	}
+	return err
 }
 
 // Set inserts or updates a record
 // key: Key to store
 // value: Value to store
 // partialUpdate: Whether to perform a partial update
+//
+// Deprecated: use SetResult, which also reports any warnings the server attached.
 func (c *HPKVClient) Set(key string, value interface{}, partialUpdate bool) (*OperationResponse, error) {
-	var valueStr interface{}
+	return c.SetContext(context.Background(), key, value, partialUpdate)
+}
 
-	// Handle string values directly, serialize other types to JSON
-	switch v := value.(type) {
-	case string:
-		valueStr = v
-	default:
-		jsonBytes, err := json.Marshal(v)
-		if err != nil {
-			return nil, fmt.Errorf("failed to serialize value: %w", err)
-		}
-		valueStr = string(jsonBytes)
+// SetContext is like Set but bounds the request to ctx, combined with the
+// client's default timeout if one is configured via WithDefaultTimeout.
+//
+// Deprecated: use SetResult, which also reports any warnings the server attached.
+func (c *HPKVClient) SetContext(ctx context.Context, key string, value interface{}, partialUpdate bool) (*OperationResponse, error) {
+	result, err := c.SetResult(ctx, key, value, partialUpdate)
+	if result == nil {
+		return nil, err
+	}
+	return result.Value, err
+}
+
+// SetResult is like Set but bounds the request to ctx and returns a Result
+// carrying the HTTP status and any warnings the server attached, alongside
+// the decoded response.
+func (c *HPKVClient) SetResult(ctx context.Context, key string, value interface{}, partialUpdate bool) (*Result[*OperationResponse], error) {
+	ctx, cancel := c.effectiveContext(ctx)
+	defer cancel()
+
+	valueStr, err := c.prepareValueForStore(value, partialUpdate)
+	if err != nil {
+		return nil, err
 	}
 
 	payload := SetRecordRequest{
@@ -78,52 +148,207 @@ func (c *HPKVClient) Set(key string, value interface{}, partialUpdate bool) (*Op
 
 	response := &OperationResponse{}
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetBody(payload).
 		SetResult(response).
 		Post("/record")
 
 	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := c.handleResponseError(resp); err != nil {
 		return nil, err
 	}
 
-	return response, c.handleResponseError(resp)
+	return &Result[*OperationResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
 }
 
 // Get retrieves a record by key
 // key: Key to retrieve
+//
+// Deprecated: use GetResult, which also reports any warnings the server attached.
 func (c *HPKVClient) Get(key string) (*GetRecordResponse, error) {
+	return c.GetContext(context.Background(), key)
+}
+
+// GetContext is like Get but bounds the request to ctx, combined with the
+// client's default timeout if one is configured via WithDefaultTimeout.
+//
+// Deprecated: use GetResult, which also reports any warnings the server attached.
+func (c *HPKVClient) GetContext(ctx context.Context, key string) (*GetRecordResponse, error) {
+	result, err := c.GetResult(ctx, key)
+	if result == nil {
+		return nil, err
+	}
+	return result.Value, err
+}
+
+// GetResult is like Get but bounds the request to ctx and returns a Result
+// carrying the HTTP status and any warnings the server attached, alongside
+// the decoded response.
+func (c *HPKVClient) GetResult(ctx context.Context, key string) (*Result[*GetRecordResponse], error) {
+	ctx, cancel := c.effectiveContext(ctx)
+	defer cancel()
+
 	response := &GetRecordResponse{}
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetResult(response).
 		Get("/record/" + url.PathEscape(key))
 
 	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := c.handleResponseError(resp); err != nil {
 		return nil, err
 	}
 
-	return response, c.handleResponseError(resp)
+	if c.cipher != nil && response.Value != "" {
+		if err := c.decryptInPlace(&response.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Result[*GetRecordResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
+}
+
+// prepareValueForStore serializes value the same way Set does (strings pass
+// through, everything else is JSON-marshaled), then, if a Cipher is
+// configured, encrypts the result and base64-encodes it for transport.
+// partialUpdate is rejected under a cipher since the server's JSON merge
+// cannot operate on ciphertext. Shared by SetResult and Txn.Commit so both
+// paths stay in sync.
+func (c *HPKVClient) prepareValueForStore(value interface{}, partialUpdate bool) (interface{}, error) {
+	var plaintext string
+
+	switch v := value.(type) {
+	case string:
+		plaintext = v
+	default:
+		jsonBytes, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize value: %w", err)
+		}
+		plaintext = string(jsonBytes)
+	}
+
+	if c.cipher == nil {
+		return plaintext, nil
+	}
+
+	if partialUpdate {
+		return nil, ErrCipherPartialUpdateUnsupported
+	}
+
+	ciphertext, err := c.cipher.Encrypt([]byte(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt value: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decryptInPlace base64-decodes and decrypts *value using the configured
+// Cipher, replacing it with the plaintext.
+func (c *HPKVClient) decryptInPlace(value *string) error {
+	ciphertext, err := base64.StdEncoding.DecodeString(*value)
+	if err != nil {
+		return fmt.Errorf("failed to decode encrypted value: %w", err)
+	}
+	plaintext, err := c.cipher.Decrypt(ciphertext)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt value: %w", err)
+	}
+	*value = string(plaintext)
+	return nil
 }
 
 // Delete removes a record
 // key: Key to delete
+//
+// Deprecated: use DeleteResult, which also reports any warnings the server attached.
 func (c *HPKVClient) Delete(key string) (*OperationResponse, error) {
+	return c.DeleteContext(context.Background(), key)
+}
+
+// DeleteContext is like Delete but bounds the request to ctx, combined with
+// the client's default timeout if one is configured via WithDefaultTimeout.
+//
+// Deprecated: use DeleteResult, which also reports any warnings the server attached.
+func (c *HPKVClient) DeleteContext(ctx context.Context, key string) (*OperationResponse, error) {
+	result, err := c.DeleteResult(ctx, key)
+	if result == nil {
+		return nil, err
+	}
+	return result.Value, err
+}
+
+// DeleteResult is like Delete but bounds the request to ctx and returns a
+// Result carrying the HTTP status and any warnings the server attached,
+// alongside the decoded response.
+func (c *HPKVClient) DeleteResult(ctx context.Context, key string) (*Result[*OperationResponse], error) {
+	ctx, cancel := c.effectiveContext(ctx)
+	defer cancel()
+
 	response := &OperationResponse{}
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetResult(response).
 		Delete("/record/" + url.PathEscape(key))
 
 	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := c.handleResponseError(resp); err != nil {
 		return nil, err
 	}
 
-	return response, c.handleResponseError(resp)
+	return &Result[*OperationResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
 }
 
 // Increment increments or decrements a numeric value
 // key: Key to increment/decrement
 // increment: Value to add (positive) or subtract (negative)
+//
+// Deprecated: use IncrementResult, which also reports any warnings the server attached.
 func (c *HPKVClient) Increment(key string, increment int) (*IncrementResponse, error) {
+	return c.IncrementContext(context.Background(), key, increment)
+}
+
+// IncrementContext is like Increment but bounds the request to ctx, combined
+// with the client's default timeout if one is configured via WithDefaultTimeout.
+//
+// Deprecated: use IncrementResult, which also reports any warnings the server attached.
+func (c *HPKVClient) IncrementContext(ctx context.Context, key string, increment int) (*IncrementResponse, error) {
+	result, err := c.IncrementResult(ctx, key, increment)
+	if result == nil {
+		return nil, err
+	}
+	return result.Value, err
+}
+
+// IncrementResult is like Increment but bounds the request to ctx and returns
+// a Result carrying the HTTP status and any warnings the server attached,
+// alongside the decoded response.
+func (c *HPKVClient) IncrementResult(ctx context.Context, key string, increment int) (*Result[*IncrementResponse], error) {
+	ctx, cancel := c.effectiveContext(ctx)
+	defer cancel()
+
 	payload := IncrementRequest{
 		Key:       url.PathEscape(key),
 		Increment: increment,
@@ -131,36 +356,101 @@ func (c *HPKVClient) Increment(key string, increment int) (*IncrementResponse, e
 
 	response := &IncrementResponse{}
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetBody(payload).
 		SetResult(response).
 		Post("/record/atomic")
 
 	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := c.handleResponseError(resp); err != nil {
 		return nil, err
 	}
 
-	return response, c.handleResponseError(resp)
+	return &Result[*IncrementResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
 }
 
 // Query fetches records within a key range
 // startKey: Starting key (inclusive)
 // endKey: Ending key (inclusive)
 // limit: Maximum number of records to return
+//
+// Deprecated: use QueryResult, which also reports any warnings the server attached.
 func (c *HPKVClient) Query(startKey, endKey string, limit int) (*RangeQueryResponse, error) {
+	return c.QueryContext(context.Background(), startKey, endKey, limit)
+}
+
+// QueryContext is like Query but bounds the request to ctx, combined with the
+// client's default timeout if one is configured via WithDefaultTimeout.
+//
+// Deprecated: use QueryResult, which also reports any warnings the server attached.
+func (c *HPKVClient) QueryContext(ctx context.Context, startKey, endKey string, limit int) (*RangeQueryResponse, error) {
+	result, err := c.QueryResult(ctx, startKey, endKey, limit)
+	if result == nil {
+		return nil, err
+	}
+	return result.Value, err
+}
+
+// QueryResult is like Query but bounds the request to ctx and returns a
+// Result carrying the HTTP status and any warnings the server attached,
+// alongside the decoded response.
+func (c *HPKVClient) QueryResult(ctx context.Context, startKey, endKey string, limit int) (*Result[*RangeQueryResponse], error) {
+	return c.queryRange(ctx, startKey, endKey, limit, false)
+}
+
+// queryRange is the shared implementation behind QueryResult and Scan's
+// paging. When keysOnly is true, the request asks the server to omit values
+// from the response entirely (rather than discarding them client-side), so
+// callers that only want key enumeration don't pay for transferring,
+// JSON-decoding, or decrypting values they never asked for.
+func (c *HPKVClient) queryRange(ctx context.Context, startKey, endKey string, limit int, keysOnly bool) (*Result[*RangeQueryResponse], error) {
+	ctx, cancel := c.effectiveContext(ctx)
+	defer cancel()
+
 	response := &RangeQueryResponse{}
-	resp, err := c.client.R().
+	req := c.client.R().
+		SetContext(ctx).
 		SetQueryParam("startKey", url.QueryEscape(startKey)).
 		SetQueryParam("endKey", url.QueryEscape(endKey)).
 		SetQueryParam("limit", strconv.Itoa(limit)).
-		SetResult(response).
-		Get("/records")
+		SetResult(response)
+	if keysOnly {
+		req.SetQueryParam("keysOnly", "true")
+	}
+	resp, err := req.Get("/records")
 
 	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := c.handleResponseError(resp); err != nil {
 		return nil, err
 	}
 
-	return response, c.handleResponseError(resp)
+	if !keysOnly && c.cipher != nil {
+		for i := range response.Records {
+			if response.Records[i].Value == "" {
+				continue
+			}
+			if err := c.decryptInPlace(&response.Records[i].Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &Result[*RangeQueryResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
 }
 
 // handleResponseError checks for and processes API errors
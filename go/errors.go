@@ -0,0 +1,12 @@
+package hpkvclient
+
+import "errors"
+
+// ErrCanceled is returned when a request is aborted because its context was
+// canceled before the HPKV API responded.
+var ErrCanceled = errors.New("hpkvclient: request canceled")
+
+// ErrDeadlineExceeded is returned when a request is aborted because its
+// effective deadline (the caller's context deadline or the client's default
+// timeout, whichever is sooner) elapsed before the HPKV API responded.
+var ErrDeadlineExceeded = errors.New("hpkvclient: deadline exceeded")
@@ -26,6 +26,12 @@ type IncrementRequest struct {
 type BaseResponse struct {
 	// Error message if operation failed
 	Error string `json:"error,omitempty"`
+	// Warnings are non-fatal notices about the request, e.g. "value
+	// truncated at 1MiB" or "partial update fell back to full replace".
+	// Populated from a top-level "warnings" field only; Result.Warnings
+	// merges these with any warnings carried on the X-HPKV-Warnings
+	// response header.
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 // GetRecordResponse is the response for get record operations
@@ -70,4 +76,90 @@ type IncrementResponse struct {
 	OperationResponse
 	// The new value after increment/decrement
 	Result int `json:"result,omitempty"`
+}
+
+// Txn Models
+
+// CmpTarget selects what part of a key's state a Cmp compares.
+type CmpTarget string
+
+const (
+	// CmpTargetValue compares a key's current value.
+	CmpTargetValue CmpTarget = "value"
+	// CmpTargetVersion compares a key's version counter.
+	CmpTargetVersion CmpTarget = "version"
+	// CmpTargetExists compares whether a key exists.
+	CmpTargetExists CmpTarget = "exists"
+)
+
+// Cmp is a single predicate evaluated as part of a transaction's If clause.
+type Cmp struct {
+	// Key is the key the predicate applies to.
+	Key string `json:"key"`
+	// Target selects what is being compared (value, version, or existence).
+	Target CmpTarget `json:"target"`
+	// Op is the comparison operator: "=", "!=", ">", "<", ">=", or "<=".
+	Op string `json:"op"`
+	// Value is the right-hand side of the comparison.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// OpType identifies the kind of operation in a transaction's Then/Else branch.
+type OpType string
+
+const (
+	// OpTypeGet reads a key's current record.
+	OpTypeGet OpType = "get"
+	// OpTypeSet stores a value, optionally as a partial update.
+	OpTypeSet OpType = "set"
+	// OpTypeDelete removes a key.
+	OpTypeDelete OpType = "delete"
+	// OpTypeIncrement adjusts a numeric value.
+	OpTypeIncrement OpType = "increment"
+)
+
+// Op is a single operation executed as part of a transaction's Then or Else branch.
+type Op struct {
+	// Type is the kind of operation to perform.
+	Type OpType `json:"type"`
+	// Key is the key the operation applies to.
+	Key string `json:"key"`
+	// Value is the value to store. Only used by OpTypeSet.
+	Value interface{} `json:"value,omitempty"`
+	// PartialUpdate requests a JSON merge instead of a full replace. Only used by OpTypeSet.
+	PartialUpdate bool `json:"partialUpdate,omitempty"`
+	// Increment is the amount to add (positive) or subtract (negative). Only used by OpTypeIncrement.
+	Increment int `json:"increment,omitempty"`
+}
+
+// TxnRequest is the envelope posted to /txn: an ordered list of compare
+// predicates plus the operations to run depending on whether they all held.
+type TxnRequest struct {
+	// Compare is the list of predicates evaluated to decide Then vs Else.
+	Compare []Cmp `json:"compare"`
+	// Success is the list of operations run when every predicate holds.
+	Success []Op `json:"success,omitempty"`
+	// Failure is the list of operations run when any predicate does not hold.
+	Failure []Op `json:"failure,omitempty"`
+}
+
+// OpResponse is the result of a single operation within a transaction. Exactly
+// one field is populated, depending on the Op's Type.
+type OpResponse struct {
+	// Get is populated for operations that read a record.
+	Get *GetRecordResponse `json:"get,omitempty"`
+	// Operation is populated for set/delete operations.
+	Operation *OperationResponse `json:"operation,omitempty"`
+	// Increment is populated for increment/decrement operations.
+	Increment *IncrementResponse `json:"increment,omitempty"`
+}
+
+// TxnResponse is the response from committing a transaction.
+type TxnResponse struct {
+	BaseResponse
+	// Succeeded reports whether the Compare predicates all held, i.e.
+	// whether the Success (true) or Failure (false) branch ran.
+	Succeeded bool `json:"succeeded"`
+	// Responses holds one entry per operation in the branch that ran, in order.
+	Responses []OpResponse `json:"responses,omitempty"`
 } 
\ No newline at end of file
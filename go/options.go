@@ -0,0 +1,49 @@
+package hpkvclient
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Option configures an HPKVClient at construction time.
+type Option func(*HPKVClient)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient error (e.g. network errors, 5xx responses).
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retry attempts.
+	MaxRetries int
+	// WaitTime is the base delay between retries.
+	WaitTime time.Duration
+	// MaxWaitTime caps the delay between retries when backing off.
+	MaxWaitTime time.Duration
+}
+
+// WithDefaultTimeout sets the client-wide default deadline applied to every
+// call that does not already carry a sooner deadline on its context. A zero
+// value (the default) disables the client-wide deadline, leaving cancellation
+// entirely up to the caller's context.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *HPKVClient) {
+		c.defaultTimeout = d
+	}
+}
+
+// WithHTTPClient overrides the underlying *http.Client used to make requests,
+// e.g. to customize transport settings such as TLS configuration or proxying.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *HPKVClient) {
+		c.client = resty.NewWithClient(hc)
+	}
+}
+
+// WithRetryPolicy configures automatic retries for transient failures. Retries
+// are attempted within the call's effective deadline, so a tight deadline can
+// still cut retries short.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *HPKVClient) {
+		c.retryPolicy = &p
+	}
+}
@@ -0,0 +1,46 @@
+package hpkvclient
+
+import (
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// warningsHeader is the response header carrying comma-separated warnings, in
+// addition to (or instead of) a "warnings" field in the decoded body.
+const warningsHeader = "X-HPKV-Warnings"
+
+// Result wraps a decoded response body with its HTTP status and any warnings
+// the server attached, following the "error + warnings" pattern: a non-nil
+// Result and a nil error together mean the call succeeded but may still have
+// something worth surfacing to the caller, e.g. "rate-limit budget 90%
+// consumed".
+type Result[T any] struct {
+	// Value is the decoded response body.
+	Value T
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Warnings are non-fatal notices about the request.
+	Warnings []string
+}
+
+// warningsFrom collects warnings from the X-HPKV-Warnings header and merges
+// them with any already present in base (typically unmarshaled from a
+// top-level "warnings" field). Called for every response that
+// handleResponseError accepts, i.e. every non-error response; error responses
+// are returned as an HPKVError instead.
+func warningsFrom(resp *resty.Response, base BaseResponse) []string {
+	var warnings []string
+	warnings = append(warnings, base.Warnings...)
+
+	if header := resp.Header().Get(warningsHeader); header != "" {
+		for _, w := range strings.Split(header, ",") {
+			w = strings.TrimSpace(w)
+			if w != "" {
+				warnings = append(warnings, w)
+			}
+		}
+	}
+
+	return warnings
+}
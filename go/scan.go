@@ -0,0 +1,184 @@
+package hpkvclient
+
+import "context"
+
+// defaultScanPageSize is the number of records fetched per underlying Query
+// call when no WithPageSize option is given.
+const defaultScanPageSize = 1000
+
+// ScanOption configures a Scan/ScanKeys iterator.
+type ScanOption func(*scanConfig)
+
+type scanConfig struct {
+	pageSize int
+	limit    int
+	keysOnly bool
+}
+
+// WithPageSize overrides how many records are fetched per underlying Query
+// call. Larger pages mean fewer round-trips but more memory per page.
+func WithPageSize(n int) ScanOption {
+	return func(c *scanConfig) { c.pageSize = n }
+}
+
+// WithScanLimit stops the scan after at most n records have been returned,
+// regardless of how many remain in [startKey, endKey].
+func WithScanLimit(n int) ScanOption {
+	return func(c *scanConfig) { c.limit = n }
+}
+
+// Scan returns an iterator over all records in [startKey, endKey], paging
+// through the server defaultScanPageSize records at a time (override with
+// WithPageSize). It advances startKey to the byte-successor of the last key
+// seen on each page, so callers never have to juggle Truncated/Count
+// themselves.
+//
+// Transient HTTP/network errors are retried only if the client was built
+// with WithRetryPolicy; Scan has no retry behavior of its own, so each page
+// fetch retries exactly as the client's other methods do, with one coherent
+// backoff schedule instead of two stacked ones.
+//
+// Usage:
+//
+//	it := client.Scan(ctx, "user:", "user:\xff")
+//	defer it.Close()
+//	for it.Next() {
+//		record := it.Record()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+func (c *HPKVClient) Scan(ctx context.Context, startKey, endKey string, opts ...ScanOption) *ScanIterator {
+	cfg := scanConfig{pageSize: defaultScanPageSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return &ScanIterator{
+		client:   c,
+		ctx:      ctx,
+		startKey: startKey,
+		endKey:   endKey,
+		cfg:      cfg,
+	}
+}
+
+// ScanKeys is like Scan, but asks the server to omit values from the
+// response entirely: callers that only want to enumerate keys skip the
+// bandwidth, JSON decoding, and (if a Cipher is configured) decryption cost
+// of values they never asked for. Each returned RecordItem's Value is empty.
+func (c *HPKVClient) ScanKeys(ctx context.Context, startKey, endKey string, opts ...ScanOption) *ScanIterator {
+	it := c.Scan(ctx, startKey, endKey, opts...)
+	it.cfg.keysOnly = true
+	return it
+}
+
+// ScanIterator walks a key range page by page. It is not safe for concurrent use.
+type ScanIterator struct {
+	client           *HPKVClient
+	ctx              context.Context
+	startKey, endKey string
+	cfg              scanConfig
+
+	buffer  []RecordItem
+	idx     int
+	current RecordItem
+
+	returned  int
+	exhausted bool
+	err       error
+}
+
+// Next advances to the next record, fetching another page from the server if
+// the current one is exhausted. It returns false when the range, the
+// configured Limit, or an error (see Err) ends the scan.
+func (it *ScanIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.buffer) {
+		if it.exhausted {
+			return false
+		}
+		if err := it.fetchPage(); err != nil {
+			it.err = err
+			return false
+		}
+		if len(it.buffer) == 0 {
+			return false
+		}
+	}
+
+	if it.cfg.limit > 0 && it.returned >= it.cfg.limit {
+		return false
+	}
+
+	it.current = it.buffer[it.idx]
+	it.idx++
+	it.returned++
+	return true
+}
+
+// Record returns the record Next just advanced to.
+func (it *ScanIterator) Record() RecordItem {
+	return it.current
+}
+
+// Err returns the error that stopped the scan, if any. Call it after Next
+// returns false to distinguish "range exhausted" from a transient failure.
+func (it *ScanIterator) Err() error {
+	return it.err
+}
+
+// Close stops the scan. It is safe to call multiple times and after the
+// range has been fully consumed.
+func (it *ScanIterator) Close() error {
+	it.exhausted = true
+	it.buffer = nil
+	return nil
+}
+
+// fetchPage pulls the next page of records and advances startKey to the
+// byte-successor of the last key seen, so the next page picks up immediately
+// after it.
+func (it *ScanIterator) fetchPage() error {
+	pageSize := it.cfg.pageSize
+	if it.cfg.limit > 0 {
+		if remaining := it.cfg.limit - it.returned; remaining < pageSize {
+			pageSize = remaining
+		}
+		if pageSize <= 0 {
+			it.exhausted = true
+			it.buffer = nil
+			return nil
+		}
+	}
+
+	// Transient HTTP/network errors are retried by the client itself (see
+	// WithRetryPolicy) inside queryRange's resty call; Scan does not layer a
+	// second retry loop on top.
+	result, err := it.client.queryRange(it.ctx, it.startKey, it.endKey, pageSize, it.cfg.keysOnly)
+	if err != nil {
+		return err
+	}
+
+	records := result.Value.Records
+	it.buffer = records
+	it.idx = 0
+
+	if !result.Value.Truncated || len(records) == 0 {
+		it.exhausted = true
+		return nil
+	}
+
+	it.startKey = successorKey(records[len(records)-1].Key)
+	return nil
+}
+
+// successorKey returns the smallest key greater than key under byte-wise
+// ordering, used to advance past the last key seen on a page.
+func successorKey(key string) string {
+	return key + "\x00"
+}
@@ -0,0 +1,176 @@
+package hpkvclient
+
+import "context"
+
+// cmpTarget is the first argument to Compare, naming the key under test. It
+// exists only so Compare reads as Compare(Key("k"), "=", "v").
+type cmpTarget struct {
+	key string
+}
+
+// Key names a key to compare in a transaction's If clause. Use it with
+// Compare, e.g. Compare(Key("k"), "=", "v").
+func Key(key string) cmpTarget {
+	return cmpTarget{key: key}
+}
+
+// Compare builds a predicate comparing a key's value against v using op
+// ("=", "!=", ">", "<", ">=", or "<=").
+func Compare(target cmpTarget, op string, v string) Cmp {
+	return Cmp{Key: target.key, Target: CmpTargetValue, Op: op, Value: v}
+}
+
+// CompareVersion builds a predicate comparing a key's version counter against
+// version using op ("=", "!=", ">", "<", ">=", or "<=").
+func CompareVersion(key string, op string, version int64) Cmp {
+	return Cmp{Key: key, Target: CmpTargetVersion, Op: op, Value: version}
+}
+
+// CompareExists builds a predicate on whether a key currently exists.
+func CompareExists(key string, exists bool) Cmp {
+	return Cmp{Key: key, Target: CmpTargetExists, Op: "=", Value: exists}
+}
+
+// OpGet builds a transaction operation that reads key's current record, as
+// Get would. Its result is reported in the corresponding OpResponse.Get.
+func OpGet(key string) Op {
+	return Op{Type: OpTypeGet, Key: key}
+}
+
+// OpSet builds a transaction operation that stores value at key, as Set would.
+func OpSet(key string, value interface{}, partialUpdate bool) Op {
+	return Op{Type: OpTypeSet, Key: key, Value: value, PartialUpdate: partialUpdate}
+}
+
+// OpDelete builds a transaction operation that removes key, as Delete would.
+func OpDelete(key string) Op {
+	return Op{Type: OpTypeDelete, Key: key}
+}
+
+// OpIncrement builds a transaction operation that adjusts key's numeric value
+// by delta, as Increment would.
+func OpIncrement(key string, delta int) Op {
+	return Op{Type: OpTypeIncrement, Key: key, Increment: delta}
+}
+
+// Txn builds an atomic compare-and-swap batch: a set of predicates (If), the
+// operations to run when they all hold (Then), and the operations to run
+// otherwise (Else). Commit sends the whole batch to the server as a single
+// request.
+//
+// Example:
+//
+//	resp, err := client.Txn(ctx).
+//		If(hpkvclient.Compare(hpkvclient.Key("status"), "=", "pending")).
+//		Then(hpkvclient.OpSet("status", "done", false), hpkvclient.OpIncrement("counter", 1)).
+//		Else(hpkvclient.OpSet("status", "conflict", false)).
+//		Commit()
+type Txn struct {
+	client *HPKVClient
+	ctx    context.Context
+
+	compare []Cmp
+	success []Op
+	failure []Op
+}
+
+// Txn starts building a new transaction bound to ctx.
+func (c *HPKVClient) Txn(ctx context.Context) *Txn {
+	return &Txn{client: c, ctx: ctx}
+}
+
+// If adds predicates that must all hold for the Then branch to run.
+func (t *Txn) If(cmps ...Cmp) *Txn {
+	t.compare = append(t.compare, cmps...)
+	return t
+}
+
+// Then adds operations run when every If predicate holds.
+func (t *Txn) Then(ops ...Op) *Txn {
+	t.success = append(t.success, ops...)
+	return t
+}
+
+// Else adds operations run when any If predicate does not hold.
+func (t *Txn) Else(ops ...Op) *Txn {
+	t.failure = append(t.failure, ops...)
+	return t
+}
+
+// Commit sends the transaction to the server as a single request and reports
+// which branch ran and its per-operation results, along with any warnings
+// the server attached. OpSet values are encrypted the same way Set encrypts
+// them when a Cipher is configured (see WithCipher); OpGet results are
+// decrypted the same way Get results are.
+func (t *Txn) Commit() (*Result[*TxnResponse], error) {
+	ctx, cancel := t.client.effectiveContext(t.ctx)
+	defer cancel()
+
+	success, err := t.client.prepareOpsForStore(t.success)
+	if err != nil {
+		return nil, err
+	}
+	failure, err := t.client.prepareOpsForStore(t.failure)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := TxnRequest{
+		Compare: t.compare,
+		Success: success,
+		Failure: failure,
+	}
+
+	response := &TxnResponse{}
+	resp, err := t.client.client.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(payload).
+		SetResult(response).
+		Post("/txn")
+
+	if err != nil {
+		return nil, translateCtxErr(err)
+	}
+
+	if err := t.client.handleResponseError(resp); err != nil {
+		return nil, err
+	}
+
+	if t.client.cipher != nil {
+		for i := range response.Responses {
+			get := response.Responses[i].Get
+			if get != nil && get.Value != "" {
+				if err := t.client.decryptInPlace(&get.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return &Result[*TxnResponse]{
+		Value:      response,
+		StatusCode: resp.StatusCode(),
+		Warnings:   warningsFrom(resp, response.BaseResponse),
+	}, nil
+}
+
+// prepareOpsForStore returns a copy of ops with every OpTypeSet value run
+// through prepareValueForStore, so Txn's Set operations are encrypted (and
+// partial updates under a cipher rejected) exactly like HPKVClient.Set.
+func (c *HPKVClient) prepareOpsForStore(ops []Op) ([]Op, error) {
+	prepared := make([]Op, len(ops))
+	for i, op := range ops {
+		if op.Type != OpTypeSet {
+			prepared[i] = op
+			continue
+		}
+		valueStr, err := c.prepareValueForStore(op.Value, op.PartialUpdate)
+		if err != nil {
+			return nil, err
+		}
+		op.Value = valueStr
+		prepared[i] = op
+	}
+	return prepared, nil
+}
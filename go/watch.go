@@ -0,0 +1,126 @@
+package hpkvclient
+
+import (
+	"context"
+
+	"github.com/hpkv/rest-client/go/watch"
+)
+
+// WatchEvent is a single change notification delivered by Watch/WatchRange.
+type WatchEvent = watch.Event
+
+// WatchEventType identifies the kind of change a WatchEvent reports.
+type WatchEventType = watch.EventType
+
+// Watch event types, re-exported from the watch package for convenience.
+const (
+	WatchEventPut    = watch.EventPut
+	WatchEventDelete = watch.EventDelete
+)
+
+// WatchOption configures a Watch/WatchRange subscription.
+type WatchOption = watch.Option
+
+// WithWatchStartRevision resumes a subscription from events after the given
+// revision, e.g. the Revision of the last WatchEvent received.
+func WithWatchStartRevision(rev int64) WatchOption {
+	return watch.WithStartRevision(rev)
+}
+
+// WithWatchBackoff overrides the reconnect backoff policy used when the
+// underlying connection drops.
+func WithWatchBackoff(b watch.BackoffPolicy) WatchOption {
+	return watch.WithBackoff(b)
+}
+
+// ErrRevisionCompacted is returned by a watch subscription when the server
+// can no longer replay from the requested revision. Callers should re-sync
+// with Query (or Scan) and start a new watch. Query and Scan results carry no
+// revision, so that watch delivers only events from the time it connects.
+var ErrRevisionCompacted = watch.ErrRevisionCompacted
+
+// WatchSubscription is a live subscription to change notifications for a key
+// or key range. It reconnects with backoff on its own; callers only need to
+// range over Events() and Close() when done.
+type WatchSubscription struct {
+	session *watch.Session
+}
+
+// Events returns the channel of notifications for this subscription. The
+// channel is closed when the subscription ends (Close was called, the
+// context was canceled, or a terminal error such as ErrRevisionCompacted
+// occurred); check Err() afterwards to distinguish the two, mirroring the
+// idiom used with time.After.
+func (w *WatchSubscription) Events() <-chan WatchEvent {
+	return w.session.Events()
+}
+
+// Err returns the error that ended the subscription, if any. Only meaningful
+// after the Events() channel has been closed.
+func (w *WatchSubscription) Err() error {
+	return w.session.Err()
+}
+
+// Close ends the subscription and stops its reconnect loop.
+func (w *WatchSubscription) Close() error {
+	return w.session.Close()
+}
+
+// watchDialer returns the Dialer used to establish watch connections: an
+// SSEDialer sharing the client's underlying *http.Client.
+func (c *HPKVClient) watchDialer() watch.Dialer {
+	return &watch.SSEDialer{HTTPClient: c.client.GetClient()}
+}
+
+// decryptValue base64-decodes and decrypts an encoded value using the
+// configured Cipher, returning the plaintext. It satisfies the
+// func(string) (string, error) shape watch.WithValueDecryptor expects.
+func (c *HPKVClient) decryptValue(encoded string) (string, error) {
+	value := encoded
+	if err := c.decryptInPlace(&value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// withCipherDecrypt prepends a value decryptor to opts when a Cipher is
+// configured, so Watch/WatchRange subscribers see plaintext the same way
+// Get/Query/Scan callers do. A caller-supplied WithValueDecryptor later in
+// opts still takes precedence.
+func (c *HPKVClient) withCipherDecrypt(opts []WatchOption) []WatchOption {
+	if c.cipher == nil {
+		return opts
+	}
+	return append([]WatchOption{watch.WithValueDecryptor(c.decryptValue)}, opts...)
+}
+
+// Watch subscribes to change notifications for a single key. The returned
+// subscription stays alive across transient disconnects, reconnecting with
+// backoff and resuming from the last observed revision. If a Cipher is
+// configured via WithCipher, Value/PrevValue are decrypted transparently,
+// the same way Get decrypts them.
+func (c *HPKVClient) Watch(ctx context.Context, key string, opts ...WatchOption) (*WatchSubscription, error) {
+	params := watch.DialParams{
+		BaseURL: c.baseURL,
+		APIKey:  c.apiKey,
+		Key:     key,
+	}
+	opts = c.withCipherDecrypt(opts)
+	return &WatchSubscription{session: watch.New(ctx, c.watchDialer(), params, opts...)}, nil
+}
+
+// WatchRange subscribes to change notifications for all keys in
+// [startKey, endKey]. It behaves like Watch, but demultiplexes events for the
+// whole range onto a single subscription, including transparent decryption
+// when a Cipher is configured.
+func (c *HPKVClient) WatchRange(ctx context.Context, startKey, endKey string, opts ...WatchOption) (*WatchSubscription, error) {
+	params := watch.DialParams{
+		BaseURL:  c.baseURL,
+		APIKey:   c.apiKey,
+		StartKey: startKey,
+		EndKey:   endKey,
+		Ranged:   true,
+	}
+	opts = c.withCipherDecrypt(opts)
+	return &WatchSubscription{session: watch.New(ctx, c.watchDialer(), params, opts...)}, nil
+}
@@ -0,0 +1,37 @@
+package watch
+
+import "time"
+
+// BackoffPolicy controls the delay between reconnect attempts after the
+// watch stream is lost.
+type BackoffPolicy struct {
+	// Initial is the delay before the first reconnect attempt.
+	Initial time.Duration
+	// Max caps the delay once it has grown through repeated failures.
+	Max time.Duration
+	// Factor is the multiplier applied to the delay after each failed attempt.
+	Factor float64
+}
+
+// DefaultBackoffPolicy returns the backoff policy used when none is supplied
+// via WithBackoff.
+func DefaultBackoffPolicy() BackoffPolicy {
+	return BackoffPolicy{
+		Initial: 200 * time.Millisecond,
+		Max:     30 * time.Second,
+		Factor:  2,
+	}
+}
+
+// next returns the delay to use after the given number of consecutive
+// failures (0-indexed), capped at Max.
+func (b BackoffPolicy) next(failures int) time.Duration {
+	d := b.Initial
+	for i := 0; i < failures; i++ {
+		d = time.Duration(float64(d) * b.Factor)
+		if d >= b.Max {
+			return b.Max
+		}
+	}
+	return d
+}
@@ -0,0 +1,41 @@
+package watch
+
+import "context"
+
+// DialParams describes the subscription a Dialer should establish.
+type DialParams struct {
+	// BaseURL is the HPKV API base URL.
+	BaseURL string
+	// APIKey authenticates the connection.
+	APIKey string
+	// Key is the single key to watch. Ignored when Ranged is true.
+	Key string
+	// StartKey and EndKey bound the watched range when Ranged is true.
+	StartKey, EndKey string
+	// Ranged selects WatchRange (StartKey/EndKey) over a single-key Watch.
+	Ranged bool
+	// FromRevision asks the server to replay events starting after this
+	// revision. Zero means "only new events from now on".
+	FromRevision int64
+}
+
+// Stream is a single underlying connection to the server's /events endpoint,
+// as established by a Dialer. Implementations translate the wire protocol
+// (SSE, WebSocket, ...) into Events.
+type Stream interface {
+	// Events returns the channel of events received on this connection. It
+	// is closed when the stream ends, whether cleanly or due to an error.
+	Events() <-chan Event
+	// Err returns the error that ended the stream, if any. It is only safe
+	// to call after Events() has been closed. ErrRevisionCompacted is
+	// returned verbatim so callers can detect it with errors.Is.
+	Err() error
+	// Close tears down the underlying connection.
+	Close() error
+}
+
+// Dialer establishes a Stream for the given subscription parameters. It is
+// called once per connection attempt, including reconnects.
+type Dialer interface {
+	Dial(ctx context.Context, params DialParams) (Stream, error)
+}
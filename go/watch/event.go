@@ -0,0 +1,54 @@
+// Package watch implements the long-lived subscription machinery behind
+// HPKVClient's Watch/WatchRange API: dialing the server's /events endpoint,
+// demultiplexing events to subscribers, and reconnecting with backoff while
+// resuming from the last observed revision.
+package watch
+
+import "errors"
+
+// EventType identifies the kind of change a watch Event reports.
+type EventType int
+
+const (
+	// EventPut indicates a key was created or updated.
+	EventPut EventType = iota
+	// EventDelete indicates a key was removed.
+	EventDelete
+)
+
+// String implements fmt.Stringer for EventType.
+func (t EventType) String() string {
+	switch t {
+	case EventPut:
+		return "PUT"
+	case EventDelete:
+		return "DELETE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Event is a single change notification for a watched key or key range.
+type Event struct {
+	// Type is the kind of change (Put or Delete).
+	Type EventType
+	// Key is the key the change applies to.
+	Key string
+	// Value is the new value. Empty for deletes.
+	Value string
+	// PrevValue is the value before the change, if the server provided one.
+	PrevValue string
+	// Revision is the monotonically increasing server revision the change
+	// was recorded at.
+	Revision int64
+}
+
+// ErrRevisionCompacted is returned when the server can no longer replay from
+// the requested revision because it has been compacted away. Callers should
+// fall back to a full Query (or HPKVClient.Scan) to re-sync state, then start
+// a new watch; Query and Scan results carry no revision to resume from.
+var ErrRevisionCompacted = errors.New("watch: requested revision has been compacted")
+
+// ErrClosed reports a closed session. Session itself does not return it:
+// after Close, Session.Err reports the canceled context's error.
+var ErrClosed = errors.New("watch: session closed")
@@ -0,0 +1,219 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Option configures a Session.
+type Option func(*config)
+
+type config struct {
+	fromRevision int64
+	backoff      BackoffPolicy
+	bufferSize   int
+	decrypt      func(string) (string, error)
+}
+
+func newConfig() config {
+	return config{
+		backoff:    DefaultBackoffPolicy(),
+		bufferSize: 16,
+	}
+}
+
+// WithStartRevision resumes the watch from events after the given revision,
+// as if the connection had been open since then. Zero (the default) means
+// "only new events from now on".
+func WithStartRevision(rev int64) Option {
+	return func(c *config) { c.fromRevision = rev }
+}
+
+// WithBackoff overrides the reconnect backoff policy.
+func WithBackoff(b BackoffPolicy) Option {
+	return func(c *config) { c.backoff = b }
+}
+
+// WithBufferSize overrides the size of the Event channel buffer. A larger
+// buffer tolerates slower consumers without blocking the reconnect loop.
+func WithBufferSize(n int) Option {
+	return func(c *config) { c.bufferSize = n }
+}
+
+// WithValueDecryptor decrypts each Event's Value and PrevValue with decrypt
+// before it is delivered to the subscriber, mirroring the transparent
+// decryption HPKVClient.Get/Query/Scan apply when a Cipher is configured. Not
+// meant to be called directly by package consumers; HPKVClient wires this up
+// automatically from its own Cipher option.
+func WithValueDecryptor(decrypt func(string) (string, error)) Option {
+	return func(c *config) { c.decrypt = decrypt }
+}
+
+// Session is a single subscription that stays alive across reconnects,
+// resuming from the last observed revision each time the stream drops.
+type Session struct {
+	events chan Event
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	err error
+}
+
+// New starts a Session against the given Dialer and subscription parameters.
+// The Session owns a background goroutine that dials, forwards events, and
+// reconnects with backoff until ctx is done or Close is called.
+func New(ctx context.Context, dialer Dialer, params DialParams, opts ...Option) *Session {
+	cfg := newConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	params.FromRevision = cfg.fromRevision
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Session{
+		events: make(chan Event, cfg.bufferSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go s.run(ctx, dialer, params, cfg)
+	return s
+}
+
+// Events returns the channel of events for this subscription. Like
+// time.After, the channel is never written to again once closed; ranging
+// over it drains any buffered events before observing the close.
+func (s *Session) Events() <-chan Event {
+	return s.events
+}
+
+// Err returns the error that ended the session, if any. It is only
+// meaningful after the Events channel has been closed.
+func (s *Session) Err() error {
+	return s.err
+}
+
+// Close tears down the session and stops the reconnect loop.
+func (s *Session) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+func (s *Session) run(ctx context.Context, dialer Dialer, params DialParams, cfg config) {
+	defer close(s.done)
+	defer close(s.events)
+
+	failures := 0
+	for {
+		if ctx.Err() != nil {
+			s.err = ctx.Err()
+			return
+		}
+
+		stream, err := dialer.Dial(ctx, params)
+		if err != nil {
+			if ctx.Err() != nil {
+				s.err = ctx.Err()
+				return
+			}
+			if !s.sleepBackoff(ctx, cfg.backoff, failures) {
+				return
+			}
+			failures++
+			continue
+		}
+
+		failures = 0
+		lastRevision, streamErr := s.forward(ctx, stream, cfg.decrypt)
+		stream.Close()
+
+		if streamErr == nil || ctx.Err() != nil {
+			s.err = ctx.Err()
+			return
+		}
+
+		if errors.Is(streamErr, ErrRevisionCompacted) {
+			s.err = ErrRevisionCompacted
+			return
+		}
+
+		if lastRevision > 0 {
+			params.FromRevision = lastRevision
+		}
+		if !s.sleepBackoff(ctx, cfg.backoff, failures) {
+			return
+		}
+		failures++
+	}
+}
+
+// forward relays events from stream to the session's output channel until
+// the stream ends, returning the last revision observed and the error that
+// ended it. The error is nil only when ctx is done; a stream that closes
+// without an error of its own is reported as an error, so run reconnects. When decrypt
+// is non-nil, it is applied to each event's Value/PrevValue before the event
+// is forwarded.
+func (s *Session) forward(ctx context.Context, stream Stream, decrypt func(string) (string, error)) (lastRevision int64, err error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return lastRevision, nil
+		case evt, ok := <-stream.Events():
+			if !ok {
+				if err := stream.Err(); err != nil {
+					return lastRevision, err
+				}
+				return lastRevision, errors.New("watch: stream closed unexpectedly")
+			}
+			lastRevision = evt.Revision
+
+			if decrypt != nil {
+				if err := decryptEvent(&evt, decrypt); err != nil {
+					return lastRevision, err
+				}
+			}
+
+			select {
+			case s.events <- evt:
+			case <-ctx.Done():
+				return lastRevision, nil
+			}
+		}
+	}
+}
+
+// decryptEvent decrypts evt's Value and PrevValue in place, leaving empty
+// values (e.g. PrevValue on the first put, or either field on a delete) untouched.
+func decryptEvent(evt *Event, decrypt func(string) (string, error)) error {
+	if evt.Value != "" {
+		plaintext, err := decrypt(evt.Value)
+		if err != nil {
+			return err
+		}
+		evt.Value = plaintext
+	}
+	if evt.PrevValue != "" {
+		plaintext, err := decrypt(evt.PrevValue)
+		if err != nil {
+			return err
+		}
+		evt.PrevValue = plaintext
+	}
+	return nil
+}
+
+// sleepBackoff waits out the reconnect delay, returning false if ctx was
+// canceled first.
+func (s *Session) sleepBackoff(ctx context.Context, backoff BackoffPolicy, failures int) bool {
+	timer := time.NewTimer(backoff.next(failures))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		s.err = ctx.Err()
+		return false
+	}
+}
@@ -0,0 +1,154 @@
+package watch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SSEDialer is the default Dialer, connecting to the server's /events
+// endpoint with Server-Sent Events. It is the reference implementation; the
+// Dialer interface exists so a WebSocket transport (or a test double) can be
+// swapped in without touching Session.
+type SSEDialer struct {
+	// HTTPClient performs the underlying streaming GET request. Must not
+	// time out the request body read.
+	HTTPClient *http.Client
+}
+
+// sseEventPayload is the JSON shape carried in each SSE "data:" line.
+type sseEventPayload struct {
+	Type      string `json:"type"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	PrevValue string `json:"prevValue"`
+	Revision  int64  `json:"revision"`
+	// Error carries a server-side error for this subscription, e.g. "compacted".
+	Error string `json:"error,omitempty"`
+}
+
+type sseStream struct {
+	resp   *http.Response
+	events chan Event
+	err    error
+	// done is closed by Close to let pump abandon an in-flight send instead
+	// of blocking forever once nothing reads Events() anymore.
+	done chan struct{}
+}
+
+func (s *sseStream) Events() <-chan Event { return s.events }
+func (s *sseStream) Err() error           { return s.err }
+
+func (s *sseStream) Close() error {
+	close(s.done)
+	return s.resp.Body.Close()
+}
+
+// Dial opens an SSE connection to /events for the given subscription.
+func (d *SSEDialer) Dial(ctx context.Context, params DialParams) (Stream, error) {
+	httpClient := d.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	q := url.Values{}
+	if params.Ranged {
+		q.Set("startKey", params.StartKey)
+		q.Set("endKey", params.EndKey)
+	} else {
+		q.Set("key", params.Key)
+	}
+	if params.FromRevision > 0 {
+		q.Set("fromRevision", strconv.FormatInt(params.FromRevision, 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(params.BaseURL, "/")+"/events?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-api-key", params.APIKey)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("watch: dial /events: HTTP %d", resp.StatusCode)
+	}
+
+	stream := &sseStream{
+		resp:   resp,
+		events: make(chan Event),
+		done:   make(chan struct{}),
+	}
+	go stream.pump()
+	return stream, nil
+}
+
+// pump reads "data: <json>" lines from the SSE body until the connection
+// ends, translating each into an Event (or recording a terminal error). Each
+// send is raced against done so a Close that lands while a decoded event is
+// in flight doesn't leave pump blocked forever on a receiver that is never
+// coming back.
+func (s *sseStream) pump() {
+	defer close(s.events)
+
+	scanner := bufio.NewScanner(s.resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "" {
+			continue
+		}
+
+		var payload sseEventPayload
+		if err := json.Unmarshal([]byte(data), &payload); err != nil {
+			s.err = fmt.Errorf("watch: decode event: %w", err)
+			return
+		}
+
+		if payload.Error != "" {
+			if payload.Error == "compacted" {
+				s.err = ErrRevisionCompacted
+			} else {
+				s.err = errors.New("watch: server error: " + payload.Error)
+			}
+			return
+		}
+
+		evt := Event{
+			Key:       payload.Key,
+			Value:     payload.Value,
+			PrevValue: payload.PrevValue,
+			Revision:  payload.Revision,
+		}
+		if payload.Type == "delete" {
+			evt.Type = EventDelete
+		} else {
+			evt.Type = EventPut
+		}
+
+		select {
+		case s.events <- evt:
+		case <-s.done:
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		s.err = err
+	}
+}